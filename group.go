@@ -0,0 +1,113 @@
+package httprouter
+
+import (
+	"net/http"
+	"strings"
+)
+
+// RouterGroup represents a set of routes that share a common path prefix and
+// middleware stack. Groups are created with Router.Group or RouterGroup.Group
+// and register their routes into the same trie as the Router they originate
+// from, via Handle/addRoute, so grouping adds no runtime lookup cost.
+type RouterGroup struct {
+	router     *Router
+	prefix     string
+	middleware Middleware
+}
+
+// Group creates a RouterGroup rooted at prefix. middleware runs for every
+// route registered on the group (and its nested groups), in addition to any
+// middleware registered on the Router itself via Use.
+func (r *Router) Group(prefix string, middleware ...func(http.Handler) http.Handler) *RouterGroup {
+	return &RouterGroup{
+		router:     r,
+		prefix:     prefix,
+		middleware: NewMiddleware(middleware...),
+	}
+}
+
+// Group creates a nested RouterGroup. The child's prefix is joined to the
+// parent's, and the child's middleware is appended after the parent's, so
+// middleware runs in registration order from outermost to innermost group.
+func (g *RouterGroup) Group(prefix string, middleware ...func(http.Handler) http.Handler) *RouterGroup {
+	return &RouterGroup{
+		router:     g.router,
+		prefix:     joinPath(g.prefix, prefix),
+		middleware: append(NewMiddleware(g.middleware...), middleware...),
+	}
+}
+
+// Use appends middleware to the group. It applies to every route registered
+// on the group after the call, and to nested groups created after the call.
+// Nested groups created before the call copied the group's middleware at
+// creation and do not see it.
+func (g *RouterGroup) Use(middleware ...func(http.Handler) http.Handler) {
+	g.middleware.Use(middleware...)
+}
+
+// GET is a shortcut for group.Handle("GET", path, middleware...)
+func (g *RouterGroup) GET(path string, middleware ...func(http.Handler) http.Handler) func(http.HandlerFunc) {
+	return g.Handle("GET", path, middleware...)
+}
+
+// HEAD is a shortcut for group.Handle("HEAD", path, middleware...)
+func (g *RouterGroup) HEAD(path string, middleware ...func(http.Handler) http.Handler) func(http.HandlerFunc) {
+	return g.Handle("HEAD", path, middleware...)
+}
+
+// POST is a shortcut for group.Handle("POST", path, middleware...)
+func (g *RouterGroup) POST(path string, middleware ...func(http.Handler) http.Handler) func(http.HandlerFunc) {
+	return g.Handle("POST", path, middleware...)
+}
+
+// PUT is a shortcut for group.Handle("PUT", path, middleware...)
+func (g *RouterGroup) PUT(path string, middleware ...func(http.Handler) http.Handler) func(http.HandlerFunc) {
+	return g.Handle("PUT", path, middleware...)
+}
+
+// PATCH is a shortcut for group.Handle("PATCH", path, middleware...)
+func (g *RouterGroup) PATCH(path string, middleware ...func(http.Handler) http.Handler) func(http.HandlerFunc) {
+	return g.Handle("PATCH", path, middleware...)
+}
+
+// DELETE is a shortcut for group.Handle("DELETE", path, middleware...)
+func (g *RouterGroup) DELETE(path string, middleware ...func(http.Handler) http.Handler) func(http.HandlerFunc) {
+	return g.Handle("DELETE", path, middleware...)
+}
+
+// Handle registers a new request handle for path under the group's prefix,
+// running the group's accumulated middleware followed by middleware, then
+// delegates to the underlying Router.Handle.
+func (g *RouterGroup) Handle(method, path string, middleware ...func(http.Handler) http.Handler) func(http.HandlerFunc) {
+	return g.router.Handle(method, joinPath(g.prefix, path), g.chain(middleware...)...)
+}
+
+// Handler is the group equivalent of Router.Handler.
+func (g *RouterGroup) Handler(method, path string, middleware ...func(http.Handler) http.Handler) func(http.Handler) {
+	return g.router.Handler(method, joinPath(g.prefix, path), g.chain(middleware...)...)
+}
+
+// HandlerFunc is the group equivalent of Router.HandlerFunc.
+func (g *RouterGroup) HandlerFunc(method, path string, middleware ...func(http.Handler) http.Handler) func(http.HandlerFunc) {
+	return func(handler http.HandlerFunc) {
+		g.Handle(method, path, middleware...)(handler)
+	}
+}
+
+// chain returns the group's middleware followed by middleware, without
+// mutating the group's own stack.
+func (g *RouterGroup) chain(middleware ...func(http.Handler) http.Handler) Middleware {
+	return append(NewMiddleware(g.middleware...), middleware...)
+}
+
+// joinPath concatenates a group prefix and a route path, ensuring exactly
+// one slash separates them.
+func joinPath(prefix, path string) string {
+	if prefix == "" {
+		return path
+	}
+	if path == "" || path == "/" {
+		return prefix
+	}
+	return strings.TrimSuffix(prefix, "/") + "/" + strings.TrimPrefix(path, "/")
+}
@@ -0,0 +1,103 @@
+package httprouter
+
+import (
+	"net/http"
+	"net/http/httptest"
+	"testing"
+)
+
+func TestGroupRunsMiddlewareBeforeHandler(t *testing.T) {
+	r := New()
+
+	var order []string
+	auth := func(next http.Handler) http.Handler {
+		return http.HandlerFunc(func(w http.ResponseWriter, req *http.Request) {
+			order = append(order, "auth")
+			next.ServeHTTP(w, req)
+		})
+	}
+
+	api := r.Group("/api", auth)
+	api.GET("/users")(func(w http.ResponseWriter, req *http.Request) {
+		order = append(order, "handler")
+	})
+
+	rec := httptest.NewRecorder()
+	r.ServeHTTP(rec, httptest.NewRequest("GET", "/api/users", nil))
+
+	if rec.Code != http.StatusOK {
+		t.Fatalf("status = %d, want %d", rec.Code, http.StatusOK)
+	}
+	if want := []string{"auth", "handler"}; !equalStrings(order, want) {
+		t.Errorf("order = %v, want %v", order, want)
+	}
+}
+
+func TestNestedGroupAppendsParentMiddlewareBeforeChild(t *testing.T) {
+	r := New()
+
+	var order []string
+	outer := func(next http.Handler) http.Handler {
+		return http.HandlerFunc(func(w http.ResponseWriter, req *http.Request) {
+			order = append(order, "outer")
+			next.ServeHTTP(w, req)
+		})
+	}
+	inner := func(next http.Handler) http.Handler {
+		return http.HandlerFunc(func(w http.ResponseWriter, req *http.Request) {
+			order = append(order, "inner")
+			next.ServeHTTP(w, req)
+		})
+	}
+
+	api := r.Group("/api", outer)
+	v1 := api.Group("/v1", inner)
+	v1.GET("/ping")(func(w http.ResponseWriter, req *http.Request) {
+		order = append(order, "handler")
+	})
+
+	rec := httptest.NewRecorder()
+	r.ServeHTTP(rec, httptest.NewRequest("GET", "/api/v1/ping", nil))
+
+	if want := []string{"outer", "inner", "handler"}; !equalStrings(order, want) {
+		t.Errorf("order = %v, want %v", order, want)
+	}
+}
+
+func TestGroupUseAppliesToSubsequentRoutesOnly(t *testing.T) {
+	r := New()
+
+	var ran bool
+	api := r.Group("/api")
+	api.GET("/before")(func(w http.ResponseWriter, req *http.Request) {})
+
+	api.Use(func(next http.Handler) http.Handler {
+		return http.HandlerFunc(func(w http.ResponseWriter, req *http.Request) {
+			ran = true
+			next.ServeHTTP(w, req)
+		})
+	})
+	api.GET("/after")(func(w http.ResponseWriter, req *http.Request) {})
+
+	r.ServeHTTP(httptest.NewRecorder(), httptest.NewRequest("GET", "/api/before", nil))
+	if ran {
+		t.Fatalf("middleware registered via Use ran for a route added before it")
+	}
+
+	r.ServeHTTP(httptest.NewRecorder(), httptest.NewRequest("GET", "/api/after", nil))
+	if !ran {
+		t.Fatalf("middleware registered via Use did not run for a route added after it")
+	}
+}
+
+func equalStrings(a, b []string) bool {
+	if len(a) != len(b) {
+		return false
+	}
+	for i := range a {
+		if a[i] != b[i] {
+			return false
+		}
+	}
+	return true
+}
@@ -6,10 +6,12 @@ type Middleware []func(http.Handler) http.Handler
 
 // NewMiddleware creates a new middleware chain.
 func NewMiddleware(middleware ...func(http.Handler) http.Handler) Middleware {
-	if middleware == nil || len(middleware) == 0 {
+	if len(middleware) == 0 {
 		return Middleware{}
 	}
-	return append(make(Middleware, len(middleware)), middleware...)
+	mw := make(Middleware, len(middleware))
+	copy(mw, middleware)
+	return mw
 }
 
 // Chains the middleware to the http.Handler endpoint.
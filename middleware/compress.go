@@ -0,0 +1,122 @@
+package middleware
+
+import (
+	"compress/flate"
+	"compress/gzip"
+	"io"
+	"net/http"
+	"strings"
+)
+
+// Compress negotiates gzip or deflate compression, based on the request's
+// Accept-Encoding header, for responses whose Content-Type matches one of
+// types (or for every response if types is empty). level is passed through
+// to the underlying compress/gzip or compress/flate writer.
+func Compress(level int, types ...string) func(http.Handler) http.Handler {
+	return func(next http.Handler) http.Handler {
+		return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			// The response varies on Accept-Encoding regardless of whether
+			// this request ends up compressed, so a cache in front of this
+			// handler doesn't serve a gzipped response to a client that
+			// never asked for one.
+			w.Header().Add("Vary", "Accept-Encoding")
+
+			encoding := negotiateEncoding(r.Header.Get("Accept-Encoding"))
+			if encoding == "" {
+				next.ServeHTTP(w, r)
+				return
+			}
+
+			cw := &compressResponseWriter{ResponseWriter: w, types: types, encoding: encoding}
+			switch encoding {
+			case "gzip":
+				gz, err := gzip.NewWriterLevel(w, level)
+				if err != nil {
+					next.ServeHTTP(w, r)
+					return
+				}
+				cw.writer = gz
+			case "deflate":
+				fl, err := flate.NewWriter(w, level)
+				if err != nil {
+					next.ServeHTTP(w, r)
+					return
+				}
+				cw.writer = fl
+			}
+
+			next.ServeHTTP(cw, r)
+
+			// Flush the compressor only if the response was compressed;
+			// closing it otherwise would append compressed framing to an
+			// uncompressed (or empty) body.
+			if c, ok := cw.writer.(io.Closer); ok && cw.decided {
+				c.Close()
+			}
+		})
+	}
+}
+
+func negotiateEncoding(acceptEncoding string) string {
+	for _, enc := range strings.Split(acceptEncoding, ",") {
+		switch strings.TrimSpace(strings.SplitN(enc, ";", 2)[0]) {
+		case "gzip":
+			return "gzip"
+		case "deflate":
+			return "deflate"
+		}
+	}
+	return ""
+}
+
+// compressResponseWriter compresses the body written by next, unless the
+// response's Content-Type doesn't match any of types, in which case it
+// falls back to writing through uncompressed.
+type compressResponseWriter struct {
+	http.ResponseWriter
+	writer   io.Writer
+	types    []string
+	encoding string
+	decided  bool
+}
+
+func (w *compressResponseWriter) shouldCompress() bool {
+	if len(w.types) == 0 {
+		return true
+	}
+	contentType := w.Header().Get("Content-Type")
+	for _, t := range w.types {
+		if strings.HasPrefix(contentType, t) {
+			return true
+		}
+	}
+	return false
+}
+
+func (w *compressResponseWriter) WriteHeader(status int) {
+	if w.shouldCompress() {
+		w.Header().Set("Content-Encoding", w.encoding)
+		w.Header().Del("Content-Length")
+	} else {
+		w.writer = nil
+	}
+	w.decided = true
+	w.ResponseWriter.WriteHeader(status)
+}
+
+func (w *compressResponseWriter) Write(b []byte) (int, error) {
+	if !w.decided {
+		// Handlers that rely on Go's implicit content-type sniffing never
+		// set Content-Type before their first Write, so the types filter
+		// above would otherwise see it empty and skip compression for
+		// them. Sniff it ourselves the same way net/http would.
+		if w.Header().Get("Content-Type") == "" {
+			w.Header().Set("Content-Type", http.DetectContentType(b))
+		}
+		w.WriteHeader(http.StatusOK)
+	}
+	if w.writer == nil {
+		return w.ResponseWriter.Write(b)
+	}
+	return w.writer.Write(b)
+}
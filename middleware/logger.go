@@ -0,0 +1,39 @@
+// Package middleware provides a small set of production-ready
+// func(http.Handler) http.Handler middlewares that compose with
+// httprouter.Middleware and Router.Use, in the style of go-chi/middleware.
+package middleware
+
+import (
+	"log"
+	"net/http"
+	"time"
+)
+
+// Logger logs an access line for every request: method, path, status code,
+// response size and duration. It wraps the ResponseWriter to capture the
+// status and byte count, neither of which net/http exposes directly.
+func Logger(next http.Handler) http.Handler {
+	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		start := time.Now()
+		lw := &loggingResponseWriter{ResponseWriter: w, status: http.StatusOK}
+		next.ServeHTTP(lw, r)
+		log.Printf("%s %s %d %dB %s", r.Method, r.URL.Path, lw.status, lw.bytes, time.Since(start))
+	})
+}
+
+type loggingResponseWriter struct {
+	http.ResponseWriter
+	status int
+	bytes  int
+}
+
+func (w *loggingResponseWriter) WriteHeader(status int) {
+	w.status = status
+	w.ResponseWriter.WriteHeader(status)
+}
+
+func (w *loggingResponseWriter) Write(b []byte) (int, error) {
+	n, err := w.ResponseWriter.Write(b)
+	w.bytes += n
+	return n, err
+}
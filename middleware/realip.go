@@ -0,0 +1,31 @@
+package middleware
+
+import (
+	"net/http"
+	"strings"
+)
+
+// RealIP overwrites req.RemoteAddr with the client address found in the
+// X-Forwarded-For or X-Real-IP headers, so downstream handlers and logging
+// middleware see the real client instead of the last proxy hop.
+//
+// Only use this behind a trusted proxy that sets these headers itself;
+// otherwise a client can spoof its own address.
+func RealIP(next http.Handler) http.Handler {
+	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if ip := realIP(r); ip != "" {
+			r.RemoteAddr = ip
+		}
+		next.ServeHTTP(w, r)
+	})
+}
+
+func realIP(r *http.Request) string {
+	if xff := r.Header.Get("X-Forwarded-For"); xff != "" {
+		if i := strings.IndexByte(xff, ','); i >= 0 {
+			xff = xff[:i]
+		}
+		return strings.TrimSpace(xff)
+	}
+	return r.Header.Get("X-Real-IP")
+}
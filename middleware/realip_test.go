@@ -0,0 +1,52 @@
+package middleware
+
+import (
+	"net/http"
+	"net/http/httptest"
+	"testing"
+)
+
+func TestRealIPFromXForwardedFor(t *testing.T) {
+	var seen string
+	handler := RealIP(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		seen = r.RemoteAddr
+	}))
+
+	req := httptest.NewRequest("GET", "/", nil)
+	req.Header.Set("X-Forwarded-For", "203.0.113.1, 10.0.0.1")
+	handler.ServeHTTP(httptest.NewRecorder(), req)
+
+	if seen != "203.0.113.1" {
+		t.Errorf("RemoteAddr = %q, want %q", seen, "203.0.113.1")
+	}
+}
+
+func TestRealIPFromXRealIP(t *testing.T) {
+	var seen string
+	handler := RealIP(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		seen = r.RemoteAddr
+	}))
+
+	req := httptest.NewRequest("GET", "/", nil)
+	req.Header.Set("X-Real-IP", "203.0.113.9")
+	handler.ServeHTTP(httptest.NewRecorder(), req)
+
+	if seen != "203.0.113.9" {
+		t.Errorf("RemoteAddr = %q, want %q", seen, "203.0.113.9")
+	}
+}
+
+func TestRealIPLeavesRemoteAddrWithoutHeaders(t *testing.T) {
+	var seen string
+	handler := RealIP(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		seen = r.RemoteAddr
+	}))
+
+	req := httptest.NewRequest("GET", "/", nil)
+	req.RemoteAddr = "198.51.100.2:1234"
+	handler.ServeHTTP(httptest.NewRecorder(), req)
+
+	if seen != "198.51.100.2:1234" {
+		t.Errorf("RemoteAddr = %q, want unchanged", seen)
+	}
+}
@@ -0,0 +1,24 @@
+package middleware
+
+import (
+	"log"
+	"net/http"
+	"runtime/debug"
+)
+
+// Recoverer recovers from panics anywhere downstream in the handler chain,
+// logs the panic value and stack trace, and responds with 500 Internal
+// Server Error instead of letting the panic take down the server. Unlike
+// Router.PanicHandler, it's a regular middleware and composes with
+// Middleware.Then like any other.
+func Recoverer(next http.Handler) http.Handler {
+	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		defer func() {
+			if rcv := recover(); rcv != nil {
+				log.Printf("panic: %v\n%s", rcv, debug.Stack())
+				http.Error(w, http.StatusText(http.StatusInternalServerError), http.StatusInternalServerError)
+			}
+		}()
+		next.ServeHTTP(w, r)
+	})
+}
@@ -0,0 +1,43 @@
+package middleware
+
+import (
+	"net/http"
+	"net/http/httptest"
+	"testing"
+)
+
+func TestRequestIDGeneratesWhenAbsent(t *testing.T) {
+	var seen string
+	handler := RequestID(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		seen = RequestIDFromContext(r.Context())
+	}))
+
+	rec := httptest.NewRecorder()
+	handler.ServeHTTP(rec, httptest.NewRequest("GET", "/", nil))
+
+	if seen == "" {
+		t.Fatal("RequestIDFromContext returned empty string for a generated ID")
+	}
+	if got := rec.Header().Get(RequestIDHeader); got != seen {
+		t.Errorf("%s header = %q, want %q", RequestIDHeader, got, seen)
+	}
+}
+
+func TestRequestIDPropagatesExisting(t *testing.T) {
+	var seen string
+	handler := RequestID(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		seen = RequestIDFromContext(r.Context())
+	}))
+
+	req := httptest.NewRequest("GET", "/", nil)
+	req.Header.Set(RequestIDHeader, "fixed-id")
+	rec := httptest.NewRecorder()
+	handler.ServeHTTP(rec, req)
+
+	if seen != "fixed-id" {
+		t.Errorf("request ID = %q, want %q", seen, "fixed-id")
+	}
+	if got := rec.Header().Get(RequestIDHeader); got != "fixed-id" {
+		t.Errorf("%s header = %q, want %q", RequestIDHeader, got, "fixed-id")
+	}
+}
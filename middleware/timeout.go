@@ -0,0 +1,19 @@
+package middleware
+
+import (
+	"net/http"
+	"time"
+)
+
+// Timeout cancels the request's context after d and, if the handler hasn't
+// returned by then, answers with 503 Service Unavailable itself, discarding
+// anything the handler had written so far —
+// handlers that never poll ctx.Done() (blocking I/O, CPU-bound work, a bare
+// time.Sleep) would otherwise hang the connection open past d. It delegates
+// to http.TimeoutHandler, which already cancels next's context at the
+// deadline.
+func Timeout(d time.Duration) func(http.Handler) http.Handler {
+	return func(next http.Handler) http.Handler {
+		return http.TimeoutHandler(next, d, http.StatusText(http.StatusServiceUnavailable))
+	}
+}
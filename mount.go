@@ -0,0 +1,50 @@
+package httprouter
+
+import (
+	"net/http"
+	"strings"
+)
+
+// mount associates a path prefix with a handler grafted via Router.Mount.
+type mount struct {
+	prefix  string
+	handler http.Handler
+}
+
+// Mount grafts handler under prefix, stripping prefix from the request path
+// (preserving RawPath) before delegating. This lets another *Router, a
+// net/http.ServeMux, or any other http.Handler be composed as a subsystem,
+// and supports incrementally migrating from a legacy handler by mounting it
+// alongside routes that are ported to the Router one at a time.
+//
+// Matching happens in ServeHTTP against the path prefix directly, after the
+// normal per-method trie lookup fails, rather than through a fixed list of
+// methods registered into the trie. That's what lets a mounted handler
+// serve methods the router itself knows nothing about, such as WebDAV's
+// PROPFIND, MKCOL, COPY, MOVE, LOCK, UNLOCK and REPORT.
+func (r *Router) Mount(prefix string, handler http.Handler) {
+	prefix = strings.TrimSuffix(prefix, "/")
+	r.mounts = append(r.mounts, mount{
+		prefix:  prefix,
+		handler: http.StripPrefix(prefix, handler),
+	})
+}
+
+// mounted returns the handler mounted at the longest prefix matching path,
+// or nil if no mount matches.
+func (r *Router) mounted(path string) http.Handler {
+	var best *mount
+	for i := range r.mounts {
+		m := &r.mounts[i]
+		if path != m.prefix && !strings.HasPrefix(path, m.prefix+"/") {
+			continue
+		}
+		if best == nil || len(m.prefix) > len(best.prefix) {
+			best = m
+		}
+	}
+	if best == nil {
+		return nil
+	}
+	return best.handler
+}
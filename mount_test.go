@@ -0,0 +1,101 @@
+package httprouter
+
+import (
+	"net/http"
+	"net/http/httptest"
+	"testing"
+)
+
+func TestMountStripsPrefixAndPreservesRawPath(t *testing.T) {
+	r := New()
+
+	var gotPath, gotRawPath string
+	r.Mount("/legacy", http.HandlerFunc(func(w http.ResponseWriter, req *http.Request) {
+		gotPath = req.URL.Path
+		gotRawPath = req.URL.RawPath
+	}))
+
+	req := httptest.NewRequest("GET", "/legacy/a%2Fb", nil)
+	r.ServeHTTP(httptest.NewRecorder(), req)
+
+	if gotPath != "/a/b" {
+		t.Errorf("Path = %q, want %q", gotPath, "/a/b")
+	}
+	if gotRawPath != "/a%2Fb" {
+		t.Errorf("RawPath = %q, want %q", gotRawPath, "/a%2Fb")
+	}
+}
+
+func TestMountLongestPrefixWins(t *testing.T) {
+	r := New()
+
+	var hit string
+	r.Mount("/api", http.HandlerFunc(func(w http.ResponseWriter, req *http.Request) {
+		hit = "api"
+	}))
+	r.Mount("/api/v2", http.HandlerFunc(func(w http.ResponseWriter, req *http.Request) {
+		hit = "api/v2"
+	}))
+
+	r.ServeHTTP(httptest.NewRecorder(), httptest.NewRequest("GET", "/api/v2/widgets", nil))
+	if hit != "api/v2" {
+		t.Errorf("hit = %q, want the more specific mount %q", hit, "api/v2")
+	}
+
+	hit = ""
+	r.ServeHTTP(httptest.NewRecorder(), httptest.NewRequest("GET", "/api/v1/widgets", nil))
+	if hit != "api" {
+		t.Errorf("hit = %q, want the less specific mount %q", hit, "api")
+	}
+}
+
+func TestMountMatchesPrefixExactlyNotByPartialSegment(t *testing.T) {
+	r := New()
+
+	var hit bool
+	r.Mount("/api", http.HandlerFunc(func(w http.ResponseWriter, req *http.Request) {
+		hit = true
+	}))
+
+	r.ServeHTTP(httptest.NewRecorder(), httptest.NewRequest("GET", "/apikeys", nil))
+	if hit {
+		t.Errorf("mount at /api matched /apikeys, want no match (not a path segment boundary)")
+	}
+
+	r.ServeHTTP(httptest.NewRecorder(), httptest.NewRequest("GET", "/api", nil))
+	if !hit {
+		t.Errorf("mount at /api did not match the bare prefix /api")
+	}
+}
+
+func TestMountServesArbitraryMethods(t *testing.T) {
+	r := New()
+
+	var gotMethod string
+	r.Mount("/dav", http.HandlerFunc(func(w http.ResponseWriter, req *http.Request) {
+		gotMethod = req.Method
+	}))
+
+	r.ServeHTTP(httptest.NewRecorder(), httptest.NewRequest("PROPFIND", "/dav/docs", nil))
+	if gotMethod != "PROPFIND" {
+		t.Errorf("method = %q, want %q to reach the mounted handler untouched", gotMethod, "PROPFIND")
+	}
+}
+
+func TestMountDoesNotShadowRegisteredRoute(t *testing.T) {
+	r := New()
+
+	r.GET("/api/widgets")(func(w http.ResponseWriter, req *http.Request) {
+		w.Header().Set("X-From", "route")
+	})
+	r.Mount("/api", http.HandlerFunc(func(w http.ResponseWriter, req *http.Request) {
+		w.Header().Set("X-From", "mount")
+	}))
+
+	rec := httptest.NewRecorder()
+	r.ServeHTTP(rec, httptest.NewRequest("GET", "/api/widgets", nil))
+
+	if got := rec.Header().Get("X-From"); got != "route" {
+		t.Errorf("X-From = %q, want the explicitly registered route to take precedence over the mount", got)
+	}
+}
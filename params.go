@@ -1,59 +1,61 @@
 package httprouter
 
 import (
-	"fmt"
 	"net/http"
 	"sync"
-)
-
-var ErrRequestNil = fmt.Errorf("*http.Request is nil")
-
-var paramsMap = make(map[*http.Request]map[string]string, 0)
-var paramsLock = new(sync.Mutex)
 
-// SetParams associates parameters with a request.
-var SetParams = defaultSetParams
-
-// UnsetParams unassociates parameters with a request.
-var UnsetParams = defaultUnsetParams
+	"golang.org/x/net/context"
+)
 
-// Params gets the parameters associated with the request. Returns nil if no parameters are associated with the request or the request is nil.
-var Params = defaultParams
+// paramsKey is the context key under which a request's path parameters are
+// stored. It's unexported so it can't collide with keys set by other
+// packages.
+type paramsKey struct{}
+
+// paramsPool recycles the maps used to hold a request's path parameters, so
+// that routing a request needs neither a global lock nor a fresh map (and a
+// fresh garbage collection target) on every call.
+var paramsPool = sync.Pool{
+	New: func() interface{} {
+		return make(map[string]string)
+	},
+}
 
-// ResetParams resets SetParams, UnsetParams and Params to the built-in functions.
-func ResetParams() {
-	SetParams = defaultSetParams
-	UnsetParams = defaultUnsetParams
-	Params = defaultParams
+func acquireParams() map[string]string {
+	return paramsPool.Get().(map[string]string)
 }
 
-func defaultSetParams(r *http.Request, params map[string]string) {
-	paramsLock.Lock()
-	paramsMap[r] = params
-	paramsLock.Unlock()
+func releaseParams(params map[string]string) {
+	for k := range params {
+		delete(params, k)
+	}
+	paramsPool.Put(params)
 }
 
-func defaultUnsetParams(r *http.Request) {
-	paramsLock.Lock()
-	delete(paramsMap, r)
-	paramsLock.Unlock()
+// withParams returns req with params attached to its context. The returned
+// *http.Request must be used for the remainder of the request's lifecycle.
+func withParams(req *http.Request, params map[string]string) *http.Request {
+	return req.WithContext(context.WithValue(req.Context(), paramsKey{}, params))
 }
 
-func defaultParams(r *http.Request) map[string]string {
+// Params gets the parameters associated with the request. Returns nil if no
+// parameters are associated with the request or the request is nil.
+func Params(r *http.Request) map[string]string {
 	if r == nil {
 		return nil
 	}
-	paramsLock.Lock()
-	if params, ok := paramsMap[r]; ok {
-		paramsLock.Unlock()
-		return params
-	}
-	paramsLock.Unlock()
-	return nil
+	return ParamsFromContext(r.Context())
+}
+
+// ParamsFromContext gets the parameters stored in ctx by the router. Returns
+// nil if ctx has no parameters associated with it.
+func ParamsFromContext(ctx context.Context) map[string]string {
+	params, _ := ctx.Value(paramsKey{}).(map[string]string)
+	return params
 }
 
+// defaultInitializer attaches params to the request's context before
+// invoking next. It's the default value of Router.handler.
 func defaultInitializer(w http.ResponseWriter, r *http.Request, params map[string]string, next http.Handler) {
-	SetParams(r, params)
-	defer UnsetParams(r)
-	next.ServeHTTP(w, r)
+	next.ServeHTTP(w, withParams(r, params))
 }
@@ -0,0 +1,65 @@
+package httprouter
+
+import (
+	"net/http"
+	"net/http/httptest"
+	"testing"
+)
+
+func TestParamsRoundTripsThroughContext(t *testing.T) {
+	req := httptest.NewRequest("GET", "/users/42", nil)
+
+	req = withParams(req, map[string]string{"id": "42"})
+
+	got := Params(req)
+	if got["id"] != "42" {
+		t.Fatalf("Params(req)[\"id\"] = %q, want %q", got["id"], "42")
+	}
+	if got2 := ParamsFromContext(req.Context()); got2["id"] != "42" {
+		t.Errorf("ParamsFromContext(req.Context())[\"id\"] = %q, want %q", got2["id"], "42")
+	}
+}
+
+func TestParamsNilWithoutRequestOrContext(t *testing.T) {
+	if got := Params(nil); got != nil {
+		t.Errorf("Params(nil) = %v, want nil", got)
+	}
+
+	req := httptest.NewRequest("GET", "/", nil)
+	if got := Params(req); got != nil {
+		t.Errorf("Params(req) without withParams = %v, want nil", got)
+	}
+}
+
+func TestParamsPoolReleaseClearsEntries(t *testing.T) {
+	params := acquireParams()
+	params["id"] = "42"
+	releaseParams(params)
+
+	// The released map must come back empty on reuse; otherwise stale keys
+	// from a previous request could leak into an unrelated one.
+	for i := 0; i < 100; i++ {
+		reused := acquireParams()
+		if len(reused) != 0 {
+			t.Fatalf("pooled params map wasn't cleared: %v", reused)
+		}
+		releaseParams(reused)
+	}
+}
+
+func TestServeHTTPAttachesParamsToHandler(t *testing.T) {
+	r := New()
+	var got string
+	r.GET("/users/:id")(func(w http.ResponseWriter, req *http.Request) {
+		// Read the value inside the handler: the pooled map is cleared once
+		// the request completes.
+		got = Params(req)["id"]
+	})
+
+	req := httptest.NewRequest("GET", "/users/42", nil)
+	r.ServeHTTP(httptest.NewRecorder(), req)
+
+	if got != "42" {
+		t.Errorf("Params(req)[\"id\"] in handler = %q, want %q", got, "42")
+	}
+}
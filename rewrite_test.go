@@ -0,0 +1,62 @@
+package httprouter
+
+import (
+	"net/http"
+	"net/http/httptest"
+	"testing"
+)
+
+func TestRewriteModeRewritesInsteadOfRedirectingNonGET(t *testing.T) {
+	r := New()
+	r.RewriteMode = true
+
+	var gotPath string
+	r.POST("/form")(func(w http.ResponseWriter, req *http.Request) {
+		gotPath = req.URL.Path
+		w.WriteHeader(http.StatusOK)
+	})
+
+	rec := httptest.NewRecorder()
+	r.ServeHTTP(rec, httptest.NewRequest("POST", "/form/", nil))
+
+	if rec.Code != http.StatusOK {
+		t.Fatalf("status = %d, want %d (RewriteMode should dispatch directly, not redirect)", rec.Code, http.StatusOK)
+	}
+	if loc := rec.Header().Get("Location"); loc != "" {
+		t.Errorf("Location = %q, want no redirect in RewriteMode", loc)
+	}
+	if gotPath != "/form" {
+		t.Errorf("handler saw path %q, want the corrected %q", gotPath, "/form")
+	}
+}
+
+func TestWithoutRewriteModeNonGETStillRedirects(t *testing.T) {
+	r := New()
+
+	r.POST("/form")(func(w http.ResponseWriter, req *http.Request) {
+		w.WriteHeader(http.StatusOK)
+	})
+
+	rec := httptest.NewRecorder()
+	r.ServeHTTP(rec, httptest.NewRequest("POST", "/form/", nil))
+
+	if rec.Code != http.StatusTemporaryRedirect {
+		t.Fatalf("status = %d, want %d", rec.Code, http.StatusTemporaryRedirect)
+	}
+}
+
+func TestRewriteModeDoesNotAffectGET(t *testing.T) {
+	r := New()
+	r.RewriteMode = true
+
+	r.GET("/form")(func(w http.ResponseWriter, req *http.Request) {
+		w.WriteHeader(http.StatusOK)
+	})
+
+	rec := httptest.NewRecorder()
+	r.ServeHTTP(rec, httptest.NewRequest("GET", "/form/", nil))
+
+	if rec.Code != http.StatusMovedPermanently {
+		t.Fatalf("status = %d, want %d (GET should still redirect)", rec.Code, http.StatusMovedPermanently)
+	}
+}
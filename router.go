@@ -76,7 +76,10 @@
 //  thirdValue := ps[2].Value // the value of the 3rd parameter
 package httprouter
 
-import "net/http"
+import (
+	"net/http"
+	"strings"
+)
 
 // Router is a http.Handler which can be used to dispatch requests to different
 // handler functions via configurable routes
@@ -84,6 +87,7 @@ type Router struct {
 	trees      map[string]*node
 	middleware *Middleware
 	handler    func(w http.ResponseWriter, r *http.Request, params map[string]string, next http.Handler)
+	mounts     []mount
 
 	// Enables automatic redirection if the current route can't be matched but a
 	// handler for the path with (without) the trailing slash exists.
@@ -126,6 +130,28 @@ type Router struct {
 	// The handler can be used to keep your server from crashing because of
 	// unrecovered panics.
 	PanicHandler func(http.ResponseWriter, *http.Request, interface{})
+
+	// If enabled, an OPTIONS request that doesn't match an explicitly
+	// registered route is answered automatically: the router sets the Allow
+	// header to the methods registered for the path, then (if set) invokes
+	// GlobalOPTIONS. If no method is registered for the path, handling falls
+	// through to HandleMethodNotAllowed/NotFound as usual.
+	HandleOPTIONS bool
+
+	// Called for every automatically handled OPTIONS request (see
+	// HandleOPTIONS), after the Allow header has been set. This is the place
+	// to add cross-cutting CORS headers such as Access-Control-Allow-Origin.
+	GlobalOPTIONS http.Handler
+
+	// If enabled, trailing-slash and path case-fixing corrections for
+	// non-GET requests are applied by rewriting req.URL.Path and
+	// re-dispatching internally, instead of issuing a 307 redirect. A 307
+	// on a POST/PUT/PATCH/DELETE is handled inconsistently by many HTTP
+	// clients when a body is involved, so RewriteMode avoids the redirect
+	// round-trip entirely for those methods. GET requests are unaffected
+	// and keep redirecting with a 301, since redirecting a GET is safe and
+	// lets the client cache/bookmark the canonical URL.
+	RewriteMode bool
 }
 
 func (r *Router) SetHandler(handler func(w http.ResponseWriter, r *http.Request, params map[string]string, next http.Handler)) {
@@ -147,6 +173,7 @@ func New() *Router {
 		RedirectTrailingSlash:  true,
 		RedirectFixedPath:      true,
 		HandleMethodNotAllowed: true,
+		HandleOPTIONS:          true,
 	}
 }
 
@@ -206,7 +233,12 @@ func (r *Router) Handle(method, path string, middleware ...func(http.Handler) ht
 			r.trees[method] = root
 		}
 
-		root.addRoute(path, NewMiddleware(middleware...).Then(handle))
+		// Capture the terminal handler's name before middleware wraps it,
+		// so Routes() can still report it afterwards.
+		root.addRoute(path, &namedHandler{
+			Handler: NewMiddleware(middleware...).Then(handle),
+			name:    handlerName(handle),
+		})
 	}
 }
 
@@ -228,7 +260,12 @@ func (r *Router) Handler(method, path string, middleware ...func(http.Handler) h
 			r.trees[method] = root
 		}
 
-		root.addRoute(path, NewMiddleware(middleware...).Then(handle))
+		// Capture the terminal handler's name before middleware wraps it,
+		// so Routes() can still report it afterwards.
+		root.addRoute(path, &namedHandler{
+			Handler: NewMiddleware(middleware...).Then(handle),
+			name:    handlerName(handle),
+		})
 	}
 }
 
@@ -269,6 +306,64 @@ func (r *Router) recv(w http.ResponseWriter, req *http.Request) {
 	}
 }
 
+// serve copies the matched ps into a pooled params map, attaches it to
+// req's context, runs the router's middleware and handler, and releases
+// the map back to the pool once the chain has completed.
+func (r *Router) serve(w http.ResponseWriter, req *http.Request, ps map[string]string, next http.Handler) {
+	params := acquireParams()
+	for k, v := range ps {
+		params[k] = v
+	}
+	defer releaseParams(params)
+
+	req = withParams(req, params)
+
+	if len(*r.middleware) > 0 {
+		next = r.middleware.Then(next)
+	}
+	r.handler(w, req, params, next)
+}
+
+// allowed returns a comma-separated list of the HTTP methods, other than
+// exclude, that have a route registered for path. It backs both the
+// automatic OPTIONS responder and the 405 Method Not Allowed handling.
+func (r *Router) allowed(path, exclude string) string {
+	var methods []string
+	for method, root := range r.trees {
+		if method == exclude {
+			continue
+		}
+		if handle, _, _ := root.getValue(path); handle != nil {
+			methods = append(methods, method)
+		}
+	}
+
+	// HEAD is implicitly served from the matching GET route (see
+	// ServeHTTP) even when no HEAD route was registered explicitly.
+	if exclude != "HEAD" {
+		if _, hasHEAD := r.trees["HEAD"]; !hasHEAD {
+			if root := r.trees["GET"]; root != nil {
+				if handle, _, _ := root.getValue(path); handle != nil {
+					methods = append(methods, "HEAD")
+				}
+			}
+		}
+	}
+
+	return strings.Join(methods, ", ")
+}
+
+// headResponseWriter discards the body written by a GET handler so the same
+// handler can answer HEAD requests, while still forwarding the status code
+// and headers.
+type headResponseWriter struct {
+	http.ResponseWriter
+}
+
+func (w *headResponseWriter) Write(b []byte) (int, error) {
+	return len(b), nil
+}
+
 // Lookup allows the manual lookup of a method + path combo.
 // This is e.g. useful to build a framework around this router.
 // If the path was found, it returns the handle function and the path parameter
@@ -287,14 +382,25 @@ func (r *Router) ServeHTTP(w http.ResponseWriter, req *http.Request) {
 		defer r.recv(w, req)
 	}
 
-	if root := r.trees[req.Method]; root != nil {
+	// A HEAD request without an explicit HEAD route is answered from the
+	// matching GET route instead, with the body discarded. Trailing-slash
+	// and case-fix corrections are also looked up in the GET tree, but, as
+	// for any non-GET method, they redirect with 307 (or are rewritten in
+	// RewriteMode) rather than with GET's 301.
+	treeMethod := req.Method
+	rw := w
+	if treeMethod == "HEAD" {
+		if _, ok := r.trees["HEAD"]; !ok {
+			treeMethod = "GET"
+			rw = &headResponseWriter{ResponseWriter: w}
+		}
+	}
+
+	if root := r.trees[treeMethod]; root != nil {
 		path := req.URL.Path
 
 		if next, ps, tsr := root.getValue(path); next != nil {
-			if len(*r.middleware) > 0 {
-				next = r.middleware.Then(next)
-			}
-			r.handler(w, req, ps, next)
+			r.serve(rw, req, ps, next)
 			return
 		} else if req.Method != "CONNECT" && path != "/" {
 			code := 301 // Permanent redirect, request with GET method
@@ -310,6 +416,10 @@ func (r *Router) ServeHTTP(w http.ResponseWriter, req *http.Request) {
 				} else {
 					req.URL.Path = path + "/"
 				}
+				if req.Method != "GET" && r.RewriteMode {
+					r.ServeHTTP(w, req)
+					return
+				}
 				http.Redirect(w, req, req.URL.String(), code)
 				return
 			}
@@ -322,6 +432,10 @@ func (r *Router) ServeHTTP(w http.ResponseWriter, req *http.Request) {
 				)
 				if found {
 					req.URL.Path = string(fixedPath)
+					if req.Method != "GET" && r.RewriteMode {
+						r.ServeHTTP(w, req)
+						return
+					}
 					http.Redirect(w, req, req.URL.String(), code)
 					return
 				}
@@ -329,26 +443,38 @@ func (r *Router) ServeHTTP(w http.ResponseWriter, req *http.Request) {
 		}
 	}
 
-	// Handle 405
-	if r.HandleMethodNotAllowed {
-		for method := range r.trees {
-			// Skip the requested method - we already tried this one
-			if method == req.Method {
-				continue
+	// Grafted sub-routers/handlers (see Mount) match on path alone, for any
+	// method, so they're checked once the router's own trees have no route
+	// for this request.
+	if handler := r.mounted(req.URL.Path); handler != nil {
+		handler.ServeHTTP(w, req)
+		return
+	}
+
+	// Handle OPTIONS
+	if req.Method == "OPTIONS" && r.HandleOPTIONS {
+		if allow := r.allowed(req.URL.Path, req.Method); allow != "" {
+			w.Header().Set("Allow", allow)
+			if r.GlobalOPTIONS != nil {
+				r.GlobalOPTIONS.ServeHTTP(w, req)
 			}
+			return
+		}
+	}
 
-			handle, _, _ := r.trees[method].getValue(req.URL.Path)
-			if handle != nil {
-				if r.MethodNotAllowed != nil {
-					r.MethodNotAllowed(w, req)
-				} else {
-					http.Error(w,
-						http.StatusText(http.StatusMethodNotAllowed),
-						http.StatusMethodNotAllowed,
-					)
-				}
-				return
+	// Handle 405
+	if r.HandleMethodNotAllowed {
+		if allow := r.allowed(req.URL.Path, req.Method); allow != "" {
+			if r.MethodNotAllowed != nil {
+				r.MethodNotAllowed(w, req)
+			} else {
+				w.Header().Set("Allow", allow)
+				http.Error(w,
+					http.StatusText(http.StatusMethodNotAllowed),
+					http.StatusMethodNotAllowed,
+				)
 			}
+			return
 		}
 	}
 
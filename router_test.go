@@ -0,0 +1,100 @@
+package httprouter
+
+import (
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+)
+
+func TestHEADAutoDispatchesFromGET(t *testing.T) {
+	r := New()
+	r.GET("/ping")(func(w http.ResponseWriter, req *http.Request) {
+		w.Write([]byte("pong"))
+	})
+
+	rec := httptest.NewRecorder()
+	r.ServeHTTP(rec, httptest.NewRequest("HEAD", "/ping", nil))
+
+	if rec.Code != http.StatusOK {
+		t.Fatalf("status = %d, want %d", rec.Code, http.StatusOK)
+	}
+	if rec.Body.Len() != 0 {
+		t.Errorf("HEAD response body = %q, want empty", rec.Body.String())
+	}
+}
+
+func TestHEADExplicitRouteTakesPrecedenceOverGET(t *testing.T) {
+	r := New()
+	r.GET("/ping")(func(w http.ResponseWriter, req *http.Request) {
+		w.Write([]byte("from-get"))
+	})
+	r.HEAD("/ping")(func(w http.ResponseWriter, req *http.Request) {
+		w.Header().Set("X-From", "explicit-head")
+	})
+
+	rec := httptest.NewRecorder()
+	r.ServeHTTP(rec, httptest.NewRequest("HEAD", "/ping", nil))
+
+	if got := rec.Header().Get("X-From"); got != "explicit-head" {
+		t.Errorf("X-From = %q, want explicit HEAD route to have run", got)
+	}
+}
+
+func TestOPTIONSListsRegisteredMethodsIncludingImplicitHEAD(t *testing.T) {
+	r := New()
+	r.GET("/x")(func(w http.ResponseWriter, req *http.Request) {})
+	r.POST("/x")(func(w http.ResponseWriter, req *http.Request) {})
+
+	rec := httptest.NewRecorder()
+	r.ServeHTTP(rec, httptest.NewRequest("OPTIONS", "/x", nil))
+
+	if rec.Code != http.StatusOK {
+		t.Fatalf("status = %d, want %d", rec.Code, http.StatusOK)
+	}
+	allow := rec.Header().Get("Allow")
+	for _, method := range []string{"GET", "HEAD", "POST"} {
+		if !strings.Contains(allow, method) {
+			t.Errorf("Allow = %q, want it to contain %q", allow, method)
+		}
+	}
+}
+
+func TestOPTIONSInvokesGlobalOPTIONS(t *testing.T) {
+	r := New()
+	r.GET("/x")(func(w http.ResponseWriter, req *http.Request) {})
+
+	var invoked bool
+	r.GlobalOPTIONS = http.HandlerFunc(func(w http.ResponseWriter, req *http.Request) {
+		invoked = true
+		w.Header().Set("Access-Control-Allow-Origin", "*")
+	})
+
+	rec := httptest.NewRecorder()
+	r.ServeHTTP(rec, httptest.NewRequest("OPTIONS", "/x", nil))
+
+	if !invoked {
+		t.Error("GlobalOPTIONS was not invoked")
+	}
+	if got := rec.Header().Get("Access-Control-Allow-Origin"); got != "*" {
+		t.Errorf("Access-Control-Allow-Origin = %q, want *", got)
+	}
+}
+
+func Test405ListsAllowedMethodsIncludingImplicitHEAD(t *testing.T) {
+	r := New()
+	r.GET("/x")(func(w http.ResponseWriter, req *http.Request) {})
+
+	rec := httptest.NewRecorder()
+	r.ServeHTTP(rec, httptest.NewRequest("DELETE", "/x", nil))
+
+	if rec.Code != http.StatusMethodNotAllowed {
+		t.Fatalf("status = %d, want %d", rec.Code, http.StatusMethodNotAllowed)
+	}
+	allow := rec.Header().Get("Allow")
+	for _, method := range []string{"GET", "HEAD"} {
+		if !strings.Contains(allow, method) {
+			t.Errorf("Allow = %q, want it to contain %q", allow, method)
+		}
+	}
+}
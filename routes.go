@@ -0,0 +1,76 @@
+package httprouter
+
+import (
+	"net/http"
+	"reflect"
+	"runtime"
+)
+
+// RouteInfo describes a single registered route, as returned by
+// Router.Routes.
+type RouteInfo struct {
+	Method      string
+	Path        string
+	HandlerName string
+}
+
+// Routes returns every route registered on the router, with the full path
+// pattern (including :name and *name segments) reconstructed from the trie
+// and the name of the terminal handler. It's useful for generating API
+// docs, building health checks that enumerate endpoints, or asserting on
+// registered routes in tests. Handlers grafted with Mount are kept outside
+// the trie and are not listed.
+func (r *Router) Routes() []RouteInfo {
+	var routes []RouteInfo
+	for method, root := range r.trees {
+		root.walk("", func(path string, handle http.Handler) {
+			routes = append(routes, RouteInfo{
+				Method:      method,
+				Path:        path,
+				HandlerName: handlerName(handle),
+			})
+		})
+	}
+	return routes
+}
+
+// walk visits every node in the trie that holds a handler, calling fn with
+// the full path pattern reconstructed from the root down to that node.
+func (n *node) walk(path string, fn func(path string, handle http.Handler)) {
+	path += n.path
+	if n.handle != nil {
+		fn(path, n.handle)
+	}
+	for _, child := range n.children {
+		child.walk(path, fn)
+	}
+}
+
+// namedHandler wraps the fully middleware-wrapped chain stored in the trie
+// while remembering the name of the terminal handler it was built from, so
+// Routes() can report something useful instead of an outer middleware
+// closure's name. Handle and Handler populate name before wrapping handle
+// with NewMiddleware(...).Then(...).
+type namedHandler struct {
+	http.Handler
+	name string
+}
+
+// handlerName derives a human-readable name for h: the name captured by
+// namedHandler at registration time if h is one, otherwise the result of
+// runtime.FuncForPC when h is a plain function value (the common case for
+// http.HandlerFunc), falling back to its concrete type name.
+func handlerName(h http.Handler) string {
+	if h == nil {
+		return ""
+	}
+	if nh, ok := h.(*namedHandler); ok {
+		return nh.name
+	}
+	if v := reflect.ValueOf(h); v.Kind() == reflect.Func {
+		if fn := runtime.FuncForPC(v.Pointer()); fn != nil {
+			return fn.Name()
+		}
+	}
+	return reflect.TypeOf(h).String()
+}
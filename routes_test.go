@@ -0,0 +1,60 @@
+package httprouter
+
+import (
+	"net/http"
+	"testing"
+)
+
+func TestRoutesReportsMethodPathAndHandlerName(t *testing.T) {
+	r := New()
+	r.GET("/users")(listUsers)
+	r.GET("/users/:id")(getUser)
+	r.GET("/files/*filepath")(getFile)
+
+	routes := r.Routes()
+	if len(routes) != 3 {
+		t.Fatalf("len(routes) = %d, want 3: %+v", len(routes), routes)
+	}
+
+	want := map[string]string{
+		"/users":           "github.com/shelakel/httprouter.listUsers",
+		"/users/:id":       "github.com/shelakel/httprouter.getUser",
+		"/files/*filepath": "github.com/shelakel/httprouter.getFile",
+	}
+	for _, ri := range routes {
+		if ri.Method != "GET" {
+			t.Errorf("Method = %q, want %q", ri.Method, "GET")
+		}
+		wantName, ok := want[ri.Path]
+		if !ok {
+			t.Errorf("unexpected path %q in Routes()", ri.Path)
+			continue
+		}
+		if ri.HandlerName != wantName {
+			t.Errorf("HandlerName for %q = %q, want %q", ri.Path, ri.HandlerName, wantName)
+		}
+		delete(want, ri.Path)
+	}
+	if len(want) != 0 {
+		t.Errorf("Routes() missing paths: %v", want)
+	}
+}
+
+func TestRoutesReportsNameThroughMiddleware(t *testing.T) {
+	r := New()
+	noop := func(next http.Handler) http.Handler { return next }
+	r.GET("/ping", noop)(pingHandler)
+
+	routes := r.Routes()
+	if len(routes) != 1 {
+		t.Fatalf("len(routes) = %d, want 1", len(routes))
+	}
+	if got, want := routes[0].HandlerName, "github.com/shelakel/httprouter.pingHandler"; got != want {
+		t.Errorf("HandlerName = %q, want %q (middleware must not shadow the terminal handler's name)", got, want)
+	}
+}
+
+func listUsers(w http.ResponseWriter, req *http.Request)   {}
+func getUser(w http.ResponseWriter, req *http.Request)     {}
+func getFile(w http.ResponseWriter, req *http.Request)     {}
+func pingHandler(w http.ResponseWriter, req *http.Request) {}